@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
@@ -21,22 +22,98 @@ import (
 
 // Client is a minimal HTTP client for talking to the Seq HTTP API.
 //
-// Authentication uses the X-Seq-ApiKey header (recommended by Seq).
+// Seq supports several authentication modes; see AuthMode on ClientConfig.
 // Ref: https://datalust.co/docs/using-the-http-api
 type Client struct {
-	baseURL *url.URL
-	apiKey  string
-	http    *http.Client
+	baseURL      *url.URL
+	http         *http.Client
+	maxRetries   int
+	retryMaxWait time.Duration
+
+	authMode    string
+	apiKey      string
+	username    string
+	password    string
+	bearerToken string
+}
+
+const (
+	defaultMaxRetries          = 5
+	defaultRetryMaxWaitSeconds = 30
+	retryBaseDelay             = 200 * time.Millisecond
+	retryCapDelay              = 3200 * time.Millisecond
+
+	// AuthModeAPIKey sends the Seq API key via the X-Seq-ApiKey header. The
+	// default, and the only mode that needs an API key at all.
+	AuthModeAPIKey = "api_key"
+	// AuthModeBearer sends a bearer token (e.g. an individually-issued user
+	// token) via the Authorization header.
+	AuthModeBearer = "bearer"
+	// AuthModeBasic sends a username/password via HTTP basic auth, for
+	// Seq's shared-secret/cookie-based session login.
+	AuthModeBasic = "basic"
+	// AuthModeNone sends no credentials at all, for setups where a reverse
+	// proxy handles authentication upstream of Seq.
+	AuthModeNone = "none"
+)
+
+// ClientConfig carries everything needed to construct a Client, decoupled
+// from the Terraform provider schema so it can be built from provider
+// configuration, env vars, or (in tests) supplied directly.
+//
+// Transport, when set, is used as the base RoundTripper instead of the
+// default TLS-aware http.Transport; this lets tests inject a recording or
+// mock transport without spinning up an httptest.Server.
+type ClientConfig struct {
+	ServerURL          string
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+	UserAgent          string
+	Transport          http.RoundTripper
+
+	// AuthMode selects how requests authenticate: AuthModeAPIKey (default),
+	// AuthModeBearer, AuthModeBasic, or AuthModeNone.
+	AuthMode string
+	// APIKey is used when AuthMode is AuthModeAPIKey.
+	APIKey string
+	// Username and Password are used when AuthMode is AuthModeBasic.
+	Username string
+	Password string
+	// BearerToken is used when AuthMode is AuthModeBearer.
+	BearerToken string
+
+	// MaxRetries is the number of retries (not counting the initial
+	// attempt) doJSON will perform for retryable failures. nil means
+	// "unset", in which case defaultMaxRetries is used; a pointer to 0
+	// disables retries entirely.
+	MaxRetries *int
+	// RetryMaxWait caps how long doJSON will ever wait between attempts,
+	// including a Retry-After value returned by the server. Zero means
+	// "unset", in which case defaultRetryMaxWaitSeconds is used.
+	RetryMaxWait time.Duration
 }
 
-func NewClientFromConfig(ctx context.Context, cfg SeqProviderModel) (*Client, diag.Diagnostics) {
+// NewClientFromConfig builds a Client from the provider's Terraform
+// configuration (and the usual SEQ_* env var fallbacks), reporting problems
+// as Terraform diagnostics. userAgent is typically
+// "terraform-provider-seq/<version> (terraform-plugin-framework)".
+func NewClientFromConfig(ctx context.Context, model SeqProviderModel, userAgent string) (*Client, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	serverURL := firstNonEmpty(
-		stringValue(cfg.ServerURL),
-		os.Getenv("SEQ_SERVER_URL"),
-	)
-	if serverURL == "" {
+	cfg := ApplyEnvDefaults(ClientConfig{
+		ServerURL:          stringValue(model.ServerURL),
+		AuthMode:           stringValue(model.AuthMode),
+		APIKey:             stringValue(model.APIKey),
+		Username:           stringValue(model.Username),
+		Password:           stringValue(model.Password),
+		BearerToken:        stringValue(model.BearerToken),
+		InsecureSkipVerify: boolValue(model.InsecureSkipVerify),
+		Timeout:            time.Duration(int64Value(model.TimeoutSeconds)) * time.Second,
+		UserAgent:          userAgent,
+		MaxRetries:         int64PtrValue(model.MaxRetries),
+		RetryMaxWait:       time.Duration(int64Value(model.RetryMaxWaitSeconds)) * time.Second,
+	})
+	if cfg.ServerURL == "" {
 		diags.AddError(
 			"Missing Seq server_url",
 			"Configure the provider with server_url or set SEQ_SERVER_URL.",
@@ -44,53 +121,165 @@ func NewClientFromConfig(ctx context.Context, cfg SeqProviderModel) (*Client, di
 		return nil, diags
 	}
 
-	parsed, err := url.Parse(serverURL)
+	c, err := NewClient(cfg)
 	if err != nil {
 		diags.AddError("Invalid server_url", err.Error())
 		return nil, diags
 	}
-	if parsed.Scheme == "" || parsed.Host == "" {
-		diags.AddError("Invalid server_url", "server_url must include scheme and host, e.g. http://localhost:5342")
-		return nil, diags
+
+	// Best-effort connectivity check.
+	if err := c.Ping(ctx); err != nil {
+		tflog.Warn(ctx, "Seq provider configured, but /health check failed", map[string]any{"error": err.Error()})
 	}
 
-	apiKey := firstNonEmpty(
-		stringValue(cfg.APIKey),
-		os.Getenv("SEQ_API_KEY"),
-	)
+	return c, diags
+}
+
+// ApplyEnvDefaults fills in empty string fields of cfg (server URL, auth
+// mode, credentials) from the SEQ_* environment variables. For
+// SEQ_INSECURE_SKIP_VERIFY, SEQ_TIMEOUT_SECONDS and SEQ_MAX_RETRIES the
+// env var, when set to a parsable value, takes precedence over the value
+// already in cfg. Remaining unset fields get their defaults. Both the
+// framework provider (via NewClientFromConfig) and the companion SDK v2
+// provider use this so the two
+// sides of the muxed provider honor the same configuration env vars.
+func ApplyEnvDefaults(cfg ClientConfig) ClientConfig {
+	cfg.ServerURL = firstNonEmpty(cfg.ServerURL, os.Getenv("SEQ_SERVER_URL"))
+	cfg.AuthMode = firstNonEmpty(cfg.AuthMode, os.Getenv("SEQ_AUTH_MODE"))
+	if cfg.AuthMode == "" {
+		cfg.AuthMode = AuthModeAPIKey
+	}
+	cfg.APIKey = firstNonEmpty(cfg.APIKey, os.Getenv("SEQ_API_KEY"))
+	cfg.Username = firstNonEmpty(cfg.Username, os.Getenv("SEQ_USERNAME"))
+	cfg.Password = firstNonEmpty(cfg.Password, os.Getenv("SEQ_PASSWORD"))
+	cfg.BearerToken = firstNonEmpty(cfg.BearerToken, os.Getenv("SEQ_BEARER_TOKEN"))
 
-	insecureSkipVerify := boolValue(cfg.InsecureSkipVerify)
 	if env := os.Getenv("SEQ_INSECURE_SKIP_VERIFY"); env != "" {
 		if v, err := strconv.ParseBool(env); err == nil {
-			insecureSkipVerify = v
+			cfg.InsecureSkipVerify = v
 		}
 	}
 
-	timeoutSeconds := int64Value(cfg.TimeoutSeconds)
-	if timeoutSeconds == 0 {
-		timeoutSeconds = 30
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 30 * time.Second
 	}
 	if env := os.Getenv("SEQ_TIMEOUT_SECONDS"); env != "" {
 		if v, err := strconv.ParseInt(env, 10, 64); err == nil {
-			timeoutSeconds = v
+			cfg.Timeout = time.Duration(v) * time.Second
+		}
+	}
+
+	if env := os.Getenv("SEQ_MAX_RETRIES"); env != "" {
+		if v, err := strconv.Atoi(env); err == nil {
+			cfg.MaxRetries = &v
 		}
 	}
+	if cfg.MaxRetries == nil {
+		v := defaultMaxRetries
+		cfg.MaxRetries = &v
+	}
+
+	if cfg.RetryMaxWait == 0 {
+		cfg.RetryMaxWait = defaultRetryMaxWaitSeconds * time.Second
+	}
+
+	return cfg
+}
+
+// NewClient constructs a Client directly from a ClientConfig, bypassing the
+// Terraform schema/diagnostics layer. It is the constructor tests and other
+// callers should use when they need to inject a custom Transport.
+func NewClient(cfg ClientConfig) (*Client, error) {
+	parsed, err := url.Parse(cfg.ServerURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("server_url must include scheme and host, e.g. http://localhost:5342")
+	}
+
+	base := cfg.Transport
+	if base == nil {
+		base = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify},
+		}
+	}
+
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = "terraform-provider-seq/dev (terraform-plugin-framework)"
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	maxRetries := defaultMaxRetries
+	if cfg.MaxRetries != nil {
+		maxRetries = *cfg.MaxRetries
+	}
+
+	retryMaxWait := cfg.RetryMaxWait
+	if retryMaxWait == 0 {
+		retryMaxWait = defaultRetryMaxWaitSeconds * time.Second
+	}
 
 	httpClient := &http.Client{
-		Timeout: time.Duration(timeoutSeconds) * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-		},
+		Timeout:   timeout,
+		Transport: &userAgentTransport{inner: base, userAgent: userAgent},
 	}
 
-	c := &Client{baseURL: parsed, apiKey: apiKey, http: httpClient}
+	authMode := cfg.AuthMode
+	if authMode == "" {
+		authMode = AuthModeAPIKey
+	}
 
-	// Best-effort connectivity check.
-	if err := c.Ping(ctx); err != nil {
-		tflog.Warn(ctx, "Seq provider configured, but /health check failed", map[string]any{"error": err.Error()})
+	return &Client{
+		baseURL:      parsed,
+		http:         httpClient,
+		maxRetries:   maxRetries,
+		retryMaxWait: retryMaxWait,
+
+		authMode:    authMode,
+		apiKey:      cfg.APIKey,
+		username:    cfg.Username,
+		password:    cfg.Password,
+		bearerToken: cfg.BearerToken,
+	}, nil
+}
+
+// WithAPIKeyOverride returns a shallow copy of c that authenticates with
+// apiKey via AuthModeAPIKey instead of c's configured credential, leaving c
+// itself untouched. It is used to let an individual resource (e.g.
+// seq_api_key) provision with a freshly-created key instead of the
+// provider's own credential, which is useful when bootstrapping a Seq
+// server from a firstrun token. A blank apiKey is a no-op.
+func (c *Client) WithAPIKeyOverride(apiKey string) *Client {
+	if apiKey == "" {
+		return c
 	}
+	clone := *c
+	clone.authMode = AuthModeAPIKey
+	clone.apiKey = apiKey
+	return &clone
+}
 
-	return c, diags
+// userAgentTransport sets a default User-Agent header on every outbound
+// request, giving Seq operators server-side visibility into which
+// Terraform/provider version is issuing API calls. It does not override a
+// User-Agent already set on the request.
+type userAgentTransport struct {
+	inner     http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.inner.RoundTrip(req)
 }
 
 func (c *Client) Ping(ctx context.Context) error {
@@ -98,64 +287,187 @@ func (c *Client) Ping(ctx context.Context) error {
 	return c.doJSON(ctx, http.MethodGet, "/health", nil, &out)
 }
 
-// doJSON performs an HTTP request with JSON body/response.
+// Do performs an authenticated JSON request against the Seq API. It is the
+// exported entry point for callers outside this package, such as the
+// companion SDK v2 provider side, which shares this *Client but cannot see
+// doJSON.
+func (c *Client) Do(ctx context.Context, method, path string, body, out any) error {
+	return c.doJSON(ctx, method, path, body, out)
+}
+
+// doJSON performs an HTTP request with JSON body/response, transparently
+// retrying on network errors, 408, 429, and 5xx responses. The request body
+// (if any) is buffered up front so it can be replayed across attempts.
 func (c *Client) doJSON(ctx context.Context, method, path string, body any, out any) error {
 	fullURL, err := c.baseURL.Parse(strings.TrimPrefix(path, "/"))
 	if err != nil {
 		return err
 	}
 
-	var bodyReader io.Reader
+	var bodyBytes []byte
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
 			return err
 		}
-		bodyReader = bytes.NewReader(b)
+		bodyBytes = b
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullURL.String(), bodyReader)
-	if err != nil {
-		return err
+	for attempt := 0; ; attempt++ {
+		status, data, retryAfter, reqErr := c.doRequestOnce(ctx, method, fullURL.String(), bodyBytes)
+
+		if reqErr == nil && status >= 200 && status <= 299 {
+			return decodeJSON(data, out)
+		}
+
+		attemptErr := reqErr
+		if attemptErr == nil {
+			attemptErr = &HTTPError{StatusCode: status, Message: httpErrorMessage(data, status)}
+		}
+
+		if attempt >= c.maxRetries || !isRetryable(method, status, reqErr) {
+			return attemptErr
+		}
+
+		wait := retryAfter
+		if wait <= 0 {
+			wait = backoffDelay(attempt)
+		}
+		if c.retryMaxWait > 0 && wait > c.retryMaxWait {
+			wait = c.retryMaxWait
+		}
+
+		tflog.Debug(ctx, "retrying Seq API request", map[string]any{
+			"method":  method,
+			"path":    path,
+			"attempt": attempt + 1,
+			"cause":   attemptErr.Error(),
+			"wait_ms": wait.Milliseconds(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
 	}
+}
 
-	if body != nil {
+// doRequestOnce performs a single HTTP attempt, returning the status code,
+// response body, and any Retry-After duration the server asked for. err is
+// only non-nil for network/transport failures, not for non-2xx responses.
+func (c *Client) doRequestOnce(ctx context.Context, method, url string, bodyBytes []byte) (status int, data []byte, retryAfter time.Duration, err error) {
+	var bodyReader io.Reader
+	if bodyBytes != nil {
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	if bodyBytes != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	if c.apiKey != "" {
-		req.Header.Set("X-Seq-ApiKey", c.apiKey)
+	switch c.authMode {
+	case AuthModeBearer:
+		if c.bearerToken != "" {
+			req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+		}
+	case AuthModeBasic:
+		if c.username != "" || c.password != "" {
+			req.SetBasicAuth(c.username, c.password)
+		}
+	case AuthModeNone:
+		// No credentials sent; authentication (if any) is handled upstream.
+	default:
+		if c.apiKey != "" {
+			req.Header.Set("X-Seq-ApiKey", c.apiKey)
+		}
 	}
 
 	resp, err := c.http.Do(req)
 	if err != nil {
-		return err
+		return 0, nil, 0, err
 	}
 	defer resp.Body.Close()
 
-	data, err := io.ReadAll(resp.Body)
+	data, err = io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return resp.StatusCode, nil, 0, err
+	}
+
+	return resp.StatusCode, data, parseRetryAfter(resp.Header.Get("Retry-After")), nil
+}
+
+// isRetryable decides whether a failed attempt should be retried. Network
+// errors and 5xx responses are always retryable. 408/429 are retryable too,
+// except on POST: Seq's non-idempotent create endpoints (e.g.
+// POST /api/apikeys) must not be retried on a 4xx response, since the first
+// attempt may have already created the resource.
+func isRetryable(method string, status int, reqErr error) bool {
+	if reqErr != nil {
+		return true
+	}
+	if status >= 500 {
+		return true
+	}
+	if method == http.MethodPost {
+		return false
 	}
+	return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		msg := strings.TrimSpace(string(data))
-		if msg == "" {
-			msg = resp.Status
+// backoffDelay returns a "full jitter" backoff delay for the given attempt
+// (0-indexed): a uniformly random duration between 0 and
+// min(retryCapDelay, retryBaseDelay*2^attempt).
+func backoffDelay(attempt int) time.Duration {
+	exp := retryBaseDelay << attempt
+	if exp <= 0 || exp > retryCapDelay {
+		exp = retryCapDelay
+	}
+	return time.Duration(rand.Int63n(int64(exp) + 1))
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 9110 is
+// either a number of seconds or an HTTP-date. It returns 0 if the header is
+// absent, unparsable, or already in the past.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs <= 0 {
+			return 0
 		}
-		return &HTTPError{StatusCode: resp.StatusCode, Message: msg}
+		return time.Duration(secs) * time.Second
 	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
 
+func httpErrorMessage(data []byte, status int) string {
+	msg := strings.TrimSpace(string(data))
+	if msg != "" {
+		return msg
+	}
+	return fmt.Sprintf("%d %s", status, http.StatusText(status))
+}
+
+func decodeJSON(data []byte, out any) error {
 	if out == nil {
 		return nil
 	}
 	if len(bytes.TrimSpace(data)) == 0 {
 		return nil
 	}
-
 	if err := json.Unmarshal(data, out); err != nil {
 		return fmt.Errorf("decode JSON response: %w", err)
 	}
-
 	return nil
 }
 
@@ -198,3 +510,14 @@ func int64Value(v types.Int64) int64 {
 	}
 	return v.ValueInt64()
 }
+
+// int64PtrValue converts v to a *int, preserving the distinction between
+// "not configured" (nil) and an explicit value, including an explicit 0 -
+// unlike int64Value, which collapses both to 0.
+func int64PtrValue(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var errNotConfigured = errors.New("provider not configured")
+
+// CredentialOverride is embedded in a resource's Terraform model to let a
+// single resource instance authenticate with its own Seq API key instead of
+// the provider's configured credential. This is useful for bootstrapping: a
+// Seq server can be provisioned with a firstrun token, and a seq_api_key
+// created from it can immediately be used to provision further resources
+// without reconfiguring the provider block.
+type CredentialOverride struct {
+	APIKeyOverride types.String `tfsdk:"api_key_override"`
+}
+
+func (o CredentialOverride) credentialAPIKeyOverride() string {
+	return stringValue(o.APIKeyOverride)
+}
+
+// credentialOverrider is implemented by any resource model embedding
+// CredentialOverride, letting crudResource fetch a per-instance credential
+// without knowing the concrete model type.
+type credentialOverrider interface {
+	credentialAPIKeyOverride() string
+}
+
+// credentialOverrideAttribute is the schema attribute every crudResource
+// model's schema should include alongside CredentialOverride.
+var credentialOverrideAttribute = schema.StringAttribute{
+	Description: "Overrides the provider's credential with a specific Seq API key for operations on this resource only. Useful when bootstrapping a server with a firstrun token and then provisioning further resources with a freshly-created key.",
+	Optional:    true,
+	Sensitive:   true,
+}
+
+// crudConfig describes how to adapt the standard Create/Read/Update/Delete
+// lifecycle against a Seq HTTP API collection (e.g. /api/apikeys,
+// /api/signals) to a specific resource's Terraform model and API response
+// type. crudResource implements resource.Resource entirely in terms of this
+// config, so adding a new Seq resource is usually just a schema, a body
+// builder, and a response mapper.
+type crudConfig[TModel credentialOverrider, TResponse any] struct {
+	// typeNameSuffix becomes "seq_<typeNameSuffix>".
+	typeNameSuffix string
+	// basePath is the Seq API collection, e.g. "/api/signals".
+	basePath string
+
+	schema schema.Schema
+
+	getID func(model TModel) string
+	setID func(model *TModel, id string)
+
+	toRequestBody func(ctx context.Context, model TModel) (map[string]any, diag.Diagnostics)
+	applyResponse func(model *TModel, resp TResponse)
+}
+
+// newCRUDResource returns a resource.Resource factory for cfg, suitable for
+// registering directly in SeqProvider.Resources.
+func newCRUDResource[TModel credentialOverrider, TResponse any](cfg crudConfig[TModel, TResponse]) func() resource.Resource {
+	return func() resource.Resource {
+		return &crudResource[TModel, TResponse]{cfg: cfg}
+	}
+}
+
+type crudResource[TModel credentialOverrider, TResponse any] struct {
+	client *Client
+	cfg    crudConfig[TModel, TResponse]
+}
+
+var _ resource.Resource = (*crudResource[CredentialOverride, struct{}])(nil)
+var _ resource.ResourceWithConfigure = (*crudResource[CredentialOverride, struct{}])(nil)
+var _ resource.ResourceWithImportState = (*crudResource[CredentialOverride, struct{}])(nil)
+
+func (r *crudResource[TModel, TResponse]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.cfg.typeNameSuffix
+}
+
+func (r *crudResource[TModel, TResponse]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.cfg.schema
+}
+
+func (r *crudResource[TModel, TResponse]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *provider.Client, got a different type.",
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *crudResource[TModel, TResponse]) checkConfigured(diags *diag.Diagnostics) bool {
+	if r.client == nil {
+		diags.AddError("Provider not configured", errNotConfigured.Error())
+		return false
+	}
+	return true
+}
+
+func (r *crudResource[TModel, TResponse]) itemPath(id string) string {
+	return r.cfg.basePath + "/" + id
+}
+
+// clientFor returns the Client to use for operations on model: the
+// provider's configured client, or a clone authenticating with the model's
+// api_key_override if one is set.
+func (r *crudResource[TModel, TResponse]) clientFor(model TModel) *Client {
+	return r.client.WithAPIKeyOverride(model.credentialAPIKeyOverride())
+}
+
+func (r *crudResource[TModel, TResponse]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.checkConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var plan TModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.cfg.toRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created TResponse
+	if err := r.clientFor(plan).doJSON(ctx, http.MethodPost, r.cfg.basePath, body, &created); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create Seq %s", r.cfg.typeNameSuffix), err.Error())
+		return
+	}
+
+	state := plan
+	r.cfg.applyResponse(&state, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *crudResource[TModel, TResponse]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.checkConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state TModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.cfg.getID(state)
+	if id == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var got TResponse
+	if err := r.clientFor(state).doJSON(ctx, http.MethodGet, r.itemPath(id), nil, &got); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read Seq %s", r.cfg.typeNameSuffix), err.Error())
+		return
+	}
+
+	r.cfg.applyResponse(&state, got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *crudResource[TModel, TResponse]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.checkConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var plan TModel
+	var state TModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.cfg.getID(state)
+	if id == "" {
+		resp.Diagnostics.AddError("Missing id", fmt.Sprintf("Cannot update Seq %s without an id in state", r.cfg.typeNameSuffix))
+		return
+	}
+
+	body, diags := r.cfg.toRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updated TResponse
+	if err := r.clientFor(plan).doJSON(ctx, http.MethodPut, r.itemPath(id), body, &updated); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update Seq %s", r.cfg.typeNameSuffix), err.Error())
+		return
+	}
+
+	newState := plan
+	r.cfg.setID(&newState, id)
+	r.cfg.applyResponse(&newState, updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *crudResource[TModel, TResponse]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.checkConfigured(&resp.Diagnostics) {
+		return
+	}
+
+	var state TModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.cfg.getID(state)
+	if id == "" {
+		return
+	}
+
+	if err := r.clientFor(state).doJSON(ctx, http.MethodDelete, r.itemPath(id), nil, nil); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete Seq %s", r.cfg.typeNameSuffix), err.Error())
+		return
+	}
+}
+
+func (r *crudResource[TModel, TResponse]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
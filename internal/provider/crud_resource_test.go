@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSignalRequestBodyOmitsUnsetFilter(t *testing.T) {
+	body, diags := signalRequestBody(context.Background(), SignalModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics")
+	}
+	if _, ok := body["Filters"]; ok {
+		t.Fatalf("expected Filters to be omitted when no filter is set")
+	}
+}
+
+func TestDoJSONCreatesSignalAgainstMockTransport(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/api/signals" || req.Method != http.MethodPost {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		return jsonResponse(req, `{"Id":"signal-1","Title":"errors","Shared":true}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	var created signalResponse
+	if err := c.doJSON(context.Background(), http.MethodPost, "/api/signals", map[string]any{"Title": "errors"}, &created); err != nil {
+		t.Fatalf("doJSON() error: %v", err)
+	}
+	if created.ID != "signal-1" || !created.Shared {
+		t.Fatalf("unexpected response: %+v", created)
+	}
+}
+
+func TestClientSendsBearerAuthorizationHeader(t *testing.T) {
+	var got string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("Authorization")
+		return jsonResponse(req, `{"status":"ok"}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport, AuthMode: AuthModeBearer, BearerToken: "tok-123"})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if got != "Bearer tok-123" {
+		t.Fatalf("expected Authorization header, got %q", got)
+	}
+}
+
+func TestClientSendsBasicAuth(t *testing.T) {
+	var gotUser, gotPass string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotUser, gotPass, _ = req.BasicAuth()
+		return jsonResponse(req, `{"status":"ok"}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport, AuthMode: AuthModeBasic, Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if gotUser != "alice" || gotPass != "secret" {
+		t.Fatalf("expected basic auth alice/secret, got %q/%q", gotUser, gotPass)
+	}
+}
+
+func TestWithAPIKeyOverrideUsesOverrideKeyOnly(t *testing.T) {
+	var got string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("X-Seq-ApiKey")
+		return jsonResponse(req, `{"status":"ok"}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport, APIKey: "provider-key"})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	overridden := c.WithAPIKeyOverride("resource-key")
+	if err := overridden.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if got != "resource-key" {
+		t.Fatalf("expected overridden API key to be used, got %q", got)
+	}
+
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if got != "provider-key" {
+		t.Fatalf("expected original client to be unaffected by override, got %q", got)
+	}
+}
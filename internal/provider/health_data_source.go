@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*HealthDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*HealthDataSource)(nil)
+
+// HealthDataSource reports the Seq server's /health status.
+//
+// Ref: https://datalust.co/docs/server-http-api#health
+type HealthDataSource struct {
+	client *Client
+}
+
+// HealthDataSourceModel is the Terraform data model for the health data source.
+type HealthDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Status types.String `tfsdk:"status"`
+}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+func (d *HealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *HealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the Seq server's health status from /health.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always set to the server's reported status; present so the data source has a stable id.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The raw status string returned by the Seq server, e.g. \"Healthy\".",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *provider.Client, got a different type.",
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", errNotConfigured.Error())
+		return
+	}
+
+	var health struct {
+		Status string `json:"Status"`
+	}
+	if err := d.client.doJSON(ctx, http.MethodGet, "/health", nil, &health); err != nil {
+		resp.Diagnostics.AddError("Failed to read Seq health", err.Error())
+		return
+	}
+
+	state := HealthDataSourceModel{
+		ID:     types.StringValue(health.Status),
+		Status: types.StringValue(health.Status),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// itemDataSourceConfig adapts the generic "read one item by id" lifecycle
+// to a specific Seq resource's Terraform model and API response type,
+// mirroring crudConfig on the resource side so a data source for a
+// crudResource-backed type is just a schema and a response mapper.
+type itemDataSourceConfig[TModel any, TResponse any] struct {
+	// typeNameSuffix becomes "seq_<typeNameSuffix>".
+	typeNameSuffix string
+	// basePath is the Seq API collection, e.g. "/api/signals".
+	basePath string
+
+	schema schema.Schema
+
+	getID         func(model TModel) string
+	applyResponse func(model *TModel, resp TResponse)
+}
+
+// newItemDataSource returns a datasource.DataSource factory for cfg.
+func newItemDataSource[TModel any, TResponse any](cfg itemDataSourceConfig[TModel, TResponse]) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &itemDataSource[TModel, TResponse]{cfg: cfg}
+	}
+}
+
+type itemDataSource[TModel any, TResponse any] struct {
+	client *Client
+	cfg    itemDataSourceConfig[TModel, TResponse]
+}
+
+var _ datasource.DataSource = (*itemDataSource[struct{}, struct{}])(nil)
+var _ datasource.DataSourceWithConfigure = (*itemDataSource[struct{}, struct{}])(nil)
+
+func (d *itemDataSource[TModel, TResponse]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.cfg.typeNameSuffix
+}
+
+func (d *itemDataSource[TModel, TResponse]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = d.cfg.schema
+}
+
+func (d *itemDataSource[TModel, TResponse]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *provider.Client, got a different type.",
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *itemDataSource[TModel, TResponse]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", errNotConfigured.Error())
+		return
+	}
+
+	var data TModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := d.cfg.getID(data)
+	if id == "" {
+		resp.Diagnostics.AddError("Missing id", "id is required to look up a "+d.cfg.typeNameSuffix)
+		return
+	}
+
+	var got TResponse
+	if err := d.client.doJSON(ctx, http.MethodGet, d.cfg.basePath+"/"+id, nil, &got); err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			resp.Diagnostics.AddError(fmt.Sprintf("Seq %s not found", d.cfg.typeNameSuffix), httpErr.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read Seq %s", d.cfg.typeNameSuffix), err.Error())
+		return
+	}
+
+	d.cfg.applyResponse(&data, got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
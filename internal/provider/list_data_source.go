@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listDataSourceConfig describes a data source that lists every item in a
+// Seq API collection. Unlike crudResource/itemDataSource, one model shape
+// (listDataSourceModel) covers every collection, since all a listing needs
+// is the set of ids plus the raw response for jsondecode().
+type listDataSourceConfig struct {
+	// typeNameSuffix becomes "seq_<typeNameSuffix>", e.g. "signals".
+	typeNameSuffix string
+	// basePath is the Seq API collection, e.g. "/api/signals".
+	basePath    string
+	description string
+}
+
+// listDataSourceModel is the Terraform data model shared by every list data
+// source.
+type listDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IDs       types.List   `tfsdk:"ids"`
+	ItemsJSON types.String `tfsdk:"items_json"`
+}
+
+func newListDataSource(cfg listDataSourceConfig) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &listDataSource{cfg: cfg}
+	}
+}
+
+type listDataSource struct {
+	client *Client
+	cfg    listDataSourceConfig
+}
+
+var _ datasource.DataSource = (*listDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*listDataSource)(nil)
+
+func (d *listDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.cfg.typeNameSuffix
+}
+
+func (d *listDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: d.cfg.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always set to the Seq API collection path that was listed.",
+				Computed:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "Ids of every item in the collection.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"items_json": schema.StringAttribute{
+				Description: "Raw JSON array returned by Seq. Decode with jsondecode() to access fields beyond id.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *listDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *provider.Client, got a different type.",
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *listDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", errNotConfigured.Error())
+		return
+	}
+
+	var raw json.RawMessage
+	if err := d.client.doJSON(ctx, http.MethodGet, d.cfg.basePath, nil, &raw); err != nil {
+		resp.Diagnostics.AddError("Failed to list Seq "+d.cfg.typeNameSuffix, err.Error())
+		return
+	}
+
+	var items []map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		resp.Diagnostics.AddError("Failed to decode Seq API response", err.Error())
+		return
+	}
+
+	ids := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		if id, ok := item["Id"].(string); ok {
+			ids = append(ids, types.StringValue(id))
+		}
+	}
+
+	state := listDataSourceModel{
+		ID:        types.StringValue(d.cfg.basePath),
+		IDs:       types.ListValueMust(types.StringType, ids),
+		ItemsJSON: types.StringValue(string(raw)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,14 +29,24 @@ type SeqProvider struct {
 //
 // Provider configuration can also be set using env vars:
 // - SEQ_SERVER_URL
+// - SEQ_AUTH_MODE
 // - SEQ_API_KEY
+// - SEQ_USERNAME / SEQ_PASSWORD
+// - SEQ_BEARER_TOKEN
 // - SEQ_INSECURE_SKIP_VERIFY
 // - SEQ_TIMEOUT_SECONDS
+// - SEQ_MAX_RETRIES
 type SeqProviderModel struct {
-	ServerURL          types.String `tfsdk:"server_url"`
-	APIKey             types.String `tfsdk:"api_key"`
-	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
-	TimeoutSeconds     types.Int64  `tfsdk:"timeout_seconds"`
+	ServerURL           types.String `tfsdk:"server_url"`
+	AuthMode            types.String `tfsdk:"auth_mode"`
+	APIKey              types.String `tfsdk:"api_key"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	BearerToken         types.String `tfsdk:"bearer_token"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	TimeoutSeconds      types.Int64  `tfsdk:"timeout_seconds"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWaitSeconds types.Int64  `tfsdk:"retry_max_wait_seconds"`
 }
 
 // New creates a new provider instance.
@@ -61,11 +72,32 @@ func (p *SeqProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"auth_mode": schema.StringAttribute{
+				Description: "Authentication scheme to use: \"api_key\" (default, sends api_key as X-Seq-ApiKey), \"bearer\" (sends bearer_token via Authorization), \"basic\" (sends username/password via HTTP basic auth), or \"none\" (sends no credentials, e.g. behind an authenticating proxy). Can be set via SEQ_AUTH_MODE.",
+				Optional:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.OneOf(AuthModeAPIKey, AuthModeBearer, AuthModeBasic, AuthModeNone),
+				},
+			},
 			"api_key": schema.StringAttribute{
 				Description: "Seq API key used for authentication. Sent as the X-Seq-ApiKey header. Can be set via SEQ_API_KEY.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"username": schema.StringAttribute{
+				Description: "Username for HTTP basic auth, used when auth_mode is \"basic\". Can be set via SEQ_USERNAME.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for HTTP basic auth, used when auth_mode is \"basic\". Can be set via SEQ_PASSWORD.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"bearer_token": schema.StringAttribute{
+				Description: "Bearer token sent via the Authorization header, used when auth_mode is \"bearer\". Can be set via SEQ_BEARER_TOKEN.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"insecure_skip_verify": schema.BoolAttribute{
 				Description: "Skip TLS certificate verification (NOT recommended). Can be set via SEQ_INSECURE_SKIP_VERIFY.",
 				Optional:    true,
@@ -74,6 +106,14 @@ func (p *SeqProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Description: "HTTP client timeout in seconds. Can be set via SEQ_TIMEOUT_SECONDS.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for requests that fail with a network error, 408, 429, or 5xx response. Defaults to 5. Can be set via SEQ_MAX_RETRIES.",
+				Optional:    true,
+			},
+			"retry_max_wait_seconds": schema.Int64Attribute{
+				Description: "Upper bound, in seconds, on how long to wait between retries, including any Retry-After value returned by the server. Defaults to 30.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -85,7 +125,8 @@ func (p *SeqProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	client, diags := NewClientFromConfig(ctx, config)
+	userAgent := fmt.Sprintf("terraform-provider-seq/%s (terraform-plugin-framework)", p.version)
+	client, diags := NewClientFromConfig(ctx, config, userAgent)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -100,11 +141,30 @@ func (p *SeqProvider) Configure(ctx context.Context, req provider.ConfigureReque
 func (p *SeqProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAPIKeyResource,
+		NewSignalResource,
+		NewRetentionPolicyResource,
+		NewDashboardResource,
+		NewUserResource,
+		NewRoleResource,
+		NewAppInstanceResource,
 	}
 }
 
 func (p *SeqProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewHealthDataSource,
+		NewSeqAPIDataSource,
+		NewSignalDataSource,
+		NewSignalsDataSource,
+		NewRetentionPolicyDataSource,
+		NewRetentionPoliciesDataSource,
+		NewDashboardDataSource,
+		NewDashboardsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
+		NewAppInstanceDataSource,
+		NewAppInstancesDataSource,
 	}
 }
@@ -2,13 +2,10 @@ package provider
 
 import (
 	"context"
-	"errors"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,17 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = (*APIKeyResource)(nil)
-var _ resource.ResourceWithConfigure = (*APIKeyResource)(nil)
-var _ resource.ResourceWithImportState = (*APIKeyResource)(nil)
-
-// APIKeyResource manages Seq API keys via /api/apikeys.
-//
-// Ref: https://datalust.co/docs/server-http-api#api-apikeys
-type APIKeyResource struct {
-	client *Client
-}
-
 // APIKeyModel is the Terraform state model for an API key.
 type APIKeyModel struct {
 	ID          types.String `tfsdk:"id"`
@@ -35,18 +21,26 @@ type APIKeyModel struct {
 	Token       types.String `tfsdk:"token"`
 	OwnerID     types.String `tfsdk:"owner_id"`
 	Permissions types.Set    `tfsdk:"permissions"`
+	CredentialOverride
 }
 
+// NewAPIKeyResource manages Seq API keys via /api/apikeys.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-apikeys
 func NewAPIKeyResource() resource.Resource {
-	return &APIKeyResource{}
-}
-
-func (r *APIKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_api_key"
-}
-
-func (r *APIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	return newCRUDResource(crudConfig[APIKeyModel, apiKeyResponse]{
+		typeNameSuffix: "api_key",
+		basePath:       "/api/apikeys",
+		schema:         apiKeySchema(),
+		getID:          func(m APIKeyModel) string { return m.ID.ValueString() },
+		setID:          func(m *APIKeyModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  apiKeyRequestBody,
+		applyResponse:  applyAPIKeyResponse,
+	})()
+}
+
+func apiKeySchema() schema.Schema {
+	return schema.Schema{
 		Description: "Manages a Seq API key.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,166 +73,11 @@ func (r *APIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"api_key_override": credentialOverrideAttribute,
 		},
 	}
 }
 
-func (r *APIKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			"Expected *provider.Client, got a different type.",
-		)
-		return
-	}
-	r.client = client
-}
-
-func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if !r.checkConfigured(&resp.Diagnostics) {
-		return
-	}
-
-	var plan APIKeyModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	body, diags := apiKeyRequestBody(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var created apiKeyResponse
-	if err := r.client.doJSON(ctx, http.MethodPost, "/api/apikeys", body, &created); err != nil {
-		resp.Diagnostics.AddError("Failed to create Seq API key", err.Error())
-		return
-	}
-
-	state := plan
-	applyAPIKeyResponse(&state, created)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if !r.checkConfigured(&resp.Diagnostics) {
-		return
-	}
-
-	var state APIKeyModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if state.ID.IsNull() || state.ID.IsUnknown() {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
-	var got apiKeyResponse
-	path := "/api/apikeys/" + state.ID.ValueString()
-	if err := r.client.doJSON(ctx, http.MethodGet, path, nil, &got); err != nil {
-		var httpErr *HTTPError
-		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
-			return
-		}
-		resp.Diagnostics.AddError("Failed to read Seq API key", err.Error())
-		return
-	}
-
-	newState := state
-	applyAPIKeyResponse(&newState, got)
-
-	// Seq may omit token on read; keep previous.
-	if got.Token == "" {
-		newState.Token = state.Token
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
-}
-
-func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if !r.checkConfigured(&resp.Diagnostics) {
-		return
-	}
-
-	var plan APIKeyModel
-	var state APIKeyModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if state.ID.IsNull() || state.ID.IsUnknown() {
-		resp.Diagnostics.AddError("Missing id", "Cannot update API key without an id in state")
-		return
-	}
-
-	body, diags := apiKeyRequestBody(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var updated apiKeyResponse
-	path := "/api/apikeys/" + state.ID.ValueString()
-	if err := r.client.doJSON(ctx, http.MethodPut, path, body, &updated); err != nil {
-		resp.Diagnostics.AddError("Failed to update Seq API key", err.Error())
-		return
-	}
-
-	newState := plan
-	newState.ID = state.ID
-	applyAPIKeyResponse(&newState, updated)
-
-	// Token may not be returned on update; keep previous.
-	if updated.Token == "" {
-		newState.Token = state.Token
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
-}
-
-func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if !r.checkConfigured(&resp.Diagnostics) {
-		return
-	}
-
-	var state APIKeyModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if state.ID.IsNull() || state.ID.IsUnknown() {
-		return
-	}
-
-	path := "/api/apikeys/" + state.ID.ValueString()
-	if err := r.client.doJSON(ctx, http.MethodDelete, path, nil, nil); err != nil {
-		var httpErr *HTTPError
-		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
-			return
-		}
-		resp.Diagnostics.AddError("Failed to delete Seq API key", err.Error())
-		return
-	}
-}
-
-func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
-
 type apiKeyResponse struct {
 	ID          string   `json:"Id"`
 	Title       string   `json:"Title"`
@@ -277,6 +116,9 @@ func applyAPIKeyResponse(state *APIKeyModel, resp apiKeyResponse) {
 	if resp.Title != "" {
 		state.Title = types.StringValue(resp.Title)
 	}
+	// Seq may omit the token from read/update responses; only overwrite it
+	// when one is actually returned, so the value from create (or the
+	// existing state) otherwise sticks.
 	if resp.Token != "" {
 		state.Token = types.StringValue(resp.Token)
 	}
@@ -295,13 +137,3 @@ func stringSliceToAttrValues(vs []string) []attr.Value {
 	}
 	return out
 }
-
-var errNotConfigured = errors.New("provider not configured")
-
-func (r *APIKeyResource) checkConfigured(respDiags *diag.Diagnostics) bool {
-	if r.client == nil {
-		respDiags.AddError("Provider not configured", errNotConfigured.Error())
-		return false
-	}
-	return true
-}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,7 +22,10 @@ func TestClientAddsAPIKeyHeader(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := &Client{baseURL: mustParseURL(srv.URL), apiKey: "abc", http: srv.Client()}
+	c, err := NewClient(ClientConfig{ServerURL: srv.URL, APIKey: "abc"})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
 	if err := c.Ping(context.Background()); err != nil {
 		t.Fatalf("Ping() error: %v", err)
 	}
@@ -29,6 +34,119 @@ func TestClientAddsAPIKeyHeader(t *testing.T) {
 	}
 }
 
+// roundTripFunc lets a test act as a mock http.RoundTripper without
+// spinning up an httptest.Server.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientSetsDefaultUserAgent(t *testing.T) {
+	var got string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("User-Agent")
+		return jsonResponse(req, `{"status":"ok"}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{
+		ServerURL: "http://seq.example.com",
+		UserAgent: "terraform-provider-seq/1.2.3 (terraform-plugin-framework)",
+		Transport: transport,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if got != "terraform-provider-seq/1.2.3 (terraform-plugin-framework)" {
+		t.Fatalf("unexpected User-Agent: %q", got)
+	}
+}
+
+func TestDoJSONRetriesOn503ThenSucceeds(t *testing.T) {
+	var attempts int
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts < 3 {
+			resp := jsonResponse(req, `{"error":"unavailable"}`)
+			resp.StatusCode = http.StatusServiceUnavailable
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		}
+		return jsonResponse(req, `{"status":"ok"}`), nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestDoJSONDoesNotRetryPostOn429(t *testing.T) {
+	var attempts int
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		resp := jsonResponse(req, `{"error":"rate limited"}`)
+		resp.StatusCode = http.StatusTooManyRequests
+		return resp, nil
+	})
+
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	var out map[string]any
+	err = c.doJSON(context.Background(), http.MethodPost, "/api/apikeys", map[string]any{"Title": "x"}, &out)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt for a non-idempotent POST, got %d", attempts)
+	}
+}
+
+func TestClientHonorsExplicitZeroMaxRetries(t *testing.T) {
+	var attempts int
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		resp := jsonResponse(req, `{"error":"unavailable"}`)
+		resp.StatusCode = http.StatusServiceUnavailable
+		return resp, nil
+	})
+
+	zero := 0
+	c, err := NewClient(ClientConfig{ServerURL: "http://seq.example.com", Transport: transport, MaxRetries: &zero})
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+
+	if err := c.Ping(context.Background()); err == nil {
+		t.Fatalf("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected max_retries=0 to disable retries entirely, got %d attempts", attempts)
+	}
+}
+
+func jsonResponse(req *http.Request, body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
 func TestAPIKeyRequestBody(t *testing.T) {
 	m := APIKeyModel{
 		Title:       types.StringValue("x"),
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AppInstanceModel is the Terraform state model for a Seq app instance (a
+// configured instance of an installed Seq App).
+type AppInstanceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Title    types.String `tfsdk:"title"`
+	AppID    types.String `tfsdk:"app_id"`
+	StreamID types.String `tfsdk:"stream_id"`
+	CredentialOverride
+}
+
+type appInstanceResponse struct {
+	ID       string `json:"Id"`
+	Title    string `json:"Title"`
+	AppID    string `json:"AppId"`
+	StreamID string `json:"StreamId"`
+}
+
+// NewAppInstanceResource manages Seq app instances via /api/appinstances.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-appinstances
+func NewAppInstanceResource() resource.Resource {
+	return newCRUDResource(crudConfig[AppInstanceModel, appInstanceResponse]{
+		typeNameSuffix: "app_instance",
+		basePath:       "/api/appinstances",
+		schema:         appInstanceSchema(),
+		getID:          func(m AppInstanceModel) string { return m.ID.ValueString() },
+		setID:          func(m *AppInstanceModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  appInstanceRequestBody,
+		applyResponse:  applyAppInstanceResponse,
+	})()
+}
+
+// NewAppInstanceDataSource reads a single Seq app instance by id.
+func NewAppInstanceDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[AppInstanceModel, appInstanceResponse]{
+		typeNameSuffix: "app_instance",
+		basePath:       "/api/appinstances",
+		schema:         appInstanceDataSourceSchema(),
+		getID:          func(m AppInstanceModel) string { return m.ID.ValueString() },
+		applyResponse:  applyAppInstanceResponse,
+	})()
+}
+
+// NewAppInstancesDataSource lists every Seq app instance.
+func NewAppInstancesDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "app_instances",
+		basePath:       "/api/appinstances",
+		description:    "Lists every Seq app instance.",
+	})()
+}
+
+func appInstanceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a configured instance of an installed Seq App.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq app instance id.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Human-friendly title for the app instance.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "Id of the installed Seq App package this is an instance of.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"stream_id": schema.StringAttribute{
+				Description: "Id of the event stream the app instance reads from. Defaults to the default stream.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func appInstanceDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq app instance by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq app instance id.",
+				Required:    true,
+			},
+			"title": dsschema.StringAttribute{
+				Description: "Human-friendly title for the app instance.",
+				Computed:    true,
+			},
+			"app_id": dsschema.StringAttribute{
+				Description: "Id of the installed Seq App package this is an instance of.",
+				Computed:    true,
+			},
+			"stream_id": dsschema.StringAttribute{
+				Description: "Id of the event stream the app instance reads from.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func appInstanceRequestBody(_ context.Context, plan AppInstanceModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"Title": plan.Title.ValueString(),
+		"AppId": plan.AppID.ValueString(),
+	}
+	if !plan.StreamID.IsNull() && !plan.StreamID.IsUnknown() && plan.StreamID.ValueString() != "" {
+		body["StreamId"] = plan.StreamID.ValueString()
+	}
+
+	return body, diags
+}
+
+func applyAppInstanceResponse(state *AppInstanceModel, resp appInstanceResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.Title != "" {
+		state.Title = types.StringValue(resp.Title)
+	}
+	if resp.AppID != "" {
+		state.AppID = types.StringValue(resp.AppID)
+	}
+	state.StreamID = types.StringValue(resp.StreamID)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DashboardModel is the Terraform state model for a Seq dashboard.
+type DashboardModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	Shared      types.Bool   `tfsdk:"shared"`
+	CredentialOverride
+}
+
+type dashboardResponse struct {
+	ID          string `json:"Id"`
+	Title       string `json:"Title"`
+	Description string `json:"Description"`
+	OwnerID     string `json:"OwnerId"`
+	Shared      bool   `json:"Shared"`
+}
+
+// NewDashboardResource manages Seq dashboards via /api/dashboards.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-dashboards
+func NewDashboardResource() resource.Resource {
+	return newCRUDResource(crudConfig[DashboardModel, dashboardResponse]{
+		typeNameSuffix: "dashboard",
+		basePath:       "/api/dashboards",
+		schema:         dashboardSchema(),
+		getID:          func(m DashboardModel) string { return m.ID.ValueString() },
+		setID:          func(m *DashboardModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  dashboardRequestBody,
+		applyResponse:  applyDashboardResponse,
+	})()
+}
+
+// NewDashboardDataSource reads a single Seq dashboard by id.
+func NewDashboardDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[DashboardModel, dashboardResponse]{
+		typeNameSuffix: "dashboard",
+		basePath:       "/api/dashboards",
+		schema:         dashboardDataSourceSchema(),
+		getID:          func(m DashboardModel) string { return m.ID.ValueString() },
+		applyResponse:  applyDashboardResponse,
+	})()
+}
+
+// NewDashboardsDataSource lists every Seq dashboard.
+func NewDashboardsDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "dashboards",
+		basePath:       "/api/dashboards",
+		description:    "Lists every Seq dashboard.",
+	})()
+}
+
+func dashboardSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a Seq dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq dashboard id.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Human-friendly title for the dashboard.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer-form description of the dashboard.",
+				Optional:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "Owner principal id. Unset for a shared/global dashboard.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"shared": schema.BoolAttribute{
+				Description: "Whether the dashboard is shared with every user, rather than private to its owner.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func dashboardDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq dashboard by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq dashboard id.",
+				Required:    true,
+			},
+			"title": dsschema.StringAttribute{
+				Description: "Human-friendly title for the dashboard.",
+				Computed:    true,
+			},
+			"description": dsschema.StringAttribute{
+				Description: "Longer-form description of the dashboard.",
+				Computed:    true,
+			},
+			"owner_id": dsschema.StringAttribute{
+				Description: "Owner principal id.",
+				Computed:    true,
+			},
+			"shared": dsschema.BoolAttribute{
+				Description: "Whether the dashboard is shared with every user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dashboardRequestBody(_ context.Context, plan DashboardModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"Title": plan.Title.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["Description"] = plan.Description.ValueString()
+	}
+	if !plan.OwnerID.IsNull() && !plan.OwnerID.IsUnknown() && plan.OwnerID.ValueString() != "" {
+		body["OwnerId"] = plan.OwnerID.ValueString()
+	}
+	if !plan.Shared.IsNull() && !plan.Shared.IsUnknown() {
+		body["Shared"] = plan.Shared.ValueBool()
+	}
+
+	return body, diags
+}
+
+func applyDashboardResponse(state *DashboardModel, resp dashboardResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.Title != "" {
+		state.Title = types.StringValue(resp.Title)
+	}
+	state.Description = types.StringValue(resp.Description)
+	state.OwnerID = types.StringValue(resp.OwnerID)
+	state.Shared = types.BoolValue(resp.Shared)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RetentionPolicyModel is the Terraform state model for a Seq retention policy.
+type RetentionPolicyModel struct {
+	ID              types.String `tfsdk:"id"`
+	RetentionTime   types.String `tfsdk:"retention_time"`
+	Description     types.String `tfsdk:"description"`
+	DeletedFromDisk types.Bool   `tfsdk:"deleted_from_disk"`
+	CredentialOverride
+}
+
+type retentionPolicyResponse struct {
+	ID              string `json:"Id"`
+	RetentionTime   string `json:"RetentionTime"`
+	Description     string `json:"Description"`
+	DeletedFromDisk bool   `json:"DeletedFromDisk"`
+}
+
+// NewRetentionPolicyResource manages Seq retention policies via /api/retention.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-retention
+func NewRetentionPolicyResource() resource.Resource {
+	return newCRUDResource(crudConfig[RetentionPolicyModel, retentionPolicyResponse]{
+		typeNameSuffix: "retention_policy",
+		basePath:       "/api/retention",
+		schema:         retentionPolicySchema(),
+		getID:          func(m RetentionPolicyModel) string { return m.ID.ValueString() },
+		setID:          func(m *RetentionPolicyModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  retentionPolicyRequestBody,
+		applyResponse:  applyRetentionPolicyResponse,
+	})()
+}
+
+// NewRetentionPolicyDataSource reads a single Seq retention policy by id.
+func NewRetentionPolicyDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[RetentionPolicyModel, retentionPolicyResponse]{
+		typeNameSuffix: "retention_policy",
+		basePath:       "/api/retention",
+		schema:         retentionPolicyDataSourceSchema(),
+		getID:          func(m RetentionPolicyModel) string { return m.ID.ValueString() },
+		applyResponse:  applyRetentionPolicyResponse,
+	})()
+}
+
+// NewRetentionPoliciesDataSource lists every Seq retention policy.
+func NewRetentionPoliciesDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "retention_policies",
+		basePath:       "/api/retention",
+		description:    "Lists every Seq retention policy.",
+	})()
+}
+
+func retentionPolicySchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a Seq retention policy, which controls how long matching events are kept before deletion.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq retention policy id.",
+				Computed:    true,
+			},
+			"retention_time": schema.StringAttribute{
+				Description: "How long events are retained, as a .NET timespan string, e.g. \"30.00:00:00\" for 30 days.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer-form description of the policy.",
+				Optional:    true,
+			},
+			"deleted_from_disk": schema.BoolAttribute{
+				Description: "Whether matching event data is removed from disk (as opposed to merely excluded from queries).",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func retentionPolicyDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq retention policy by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq retention policy id.",
+				Required:    true,
+			},
+			"retention_time": dsschema.StringAttribute{
+				Description: "How long events are retained, as a .NET timespan string.",
+				Computed:    true,
+			},
+			"description": dsschema.StringAttribute{
+				Description: "Longer-form description of the policy.",
+				Computed:    true,
+			},
+			"deleted_from_disk": dsschema.BoolAttribute{
+				Description: "Whether matching event data is removed from disk.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func retentionPolicyRequestBody(_ context.Context, plan RetentionPolicyModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"RetentionTime": plan.RetentionTime.ValueString(),
+	}
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["Description"] = plan.Description.ValueString()
+	}
+	if !plan.DeletedFromDisk.IsNull() && !plan.DeletedFromDisk.IsUnknown() {
+		body["DeletedFromDisk"] = plan.DeletedFromDisk.ValueBool()
+	}
+
+	return body, diags
+}
+
+func applyRetentionPolicyResponse(state *RetentionPolicyModel, resp retentionPolicyResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.RetentionTime != "" {
+		state.RetentionTime = types.StringValue(resp.RetentionTime)
+	}
+	state.Description = types.StringValue(resp.Description)
+	state.DeletedFromDisk = types.BoolValue(resp.DeletedFromDisk)
+}
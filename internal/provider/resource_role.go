@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoleModel is the Terraform state model for a Seq role.
+type RoleModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	CredentialOverride
+}
+
+type roleResponse struct {
+	ID          string   `json:"Id"`
+	Title       string   `json:"Title"`
+	Permissions []string `json:"Permissions"`
+	IsDefault   bool     `json:"IsDefault"`
+}
+
+// NewRoleResource manages Seq roles via /api/roles.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-roles
+func NewRoleResource() resource.Resource {
+	return newCRUDResource(crudConfig[RoleModel, roleResponse]{
+		typeNameSuffix: "role",
+		basePath:       "/api/roles",
+		schema:         roleSchema(),
+		getID:          func(m RoleModel) string { return m.ID.ValueString() },
+		setID:          func(m *RoleModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  roleRequestBody,
+		applyResponse:  applyRoleResponse,
+	})()
+}
+
+// NewRoleDataSource reads a single Seq role by id.
+func NewRoleDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[RoleModel, roleResponse]{
+		typeNameSuffix: "role",
+		basePath:       "/api/roles",
+		schema:         roleDataSourceSchema(),
+		getID:          func(m RoleModel) string { return m.ID.ValueString() },
+		applyResponse:  applyRoleResponse,
+	})()
+}
+
+// NewRolesDataSource lists every Seq role.
+func NewRolesDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "roles",
+		basePath:       "/api/roles",
+		description:    "Lists every Seq role.",
+	})()
+}
+
+func roleSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a Seq role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq role id.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Human-friendly title for the role.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Permissions granted by the role (e.g. Read, Write, Ingest, Project, System).",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether the role is automatically assigned to newly-created users.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func roleDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq role by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq role id.",
+				Required:    true,
+			},
+			"title": dsschema.StringAttribute{
+				Description: "Human-friendly title for the role.",
+				Computed:    true,
+			},
+			"permissions": dsschema.SetAttribute{
+				Description: "Permissions granted by the role.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"is_default": dsschema.BoolAttribute{
+				Description: "Whether the role is automatically assigned to newly-created users.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func roleRequestBody(ctx context.Context, plan RoleModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"Title": plan.Title.ValueString(),
+	}
+	if !plan.Permissions.IsNull() && !plan.Permissions.IsUnknown() {
+		var perms []string
+		diags.Append(plan.Permissions.ElementsAs(ctx, &perms, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		body["Permissions"] = perms
+	}
+	if !plan.IsDefault.IsNull() && !plan.IsDefault.IsUnknown() {
+		body["IsDefault"] = plan.IsDefault.ValueBool()
+	}
+
+	return body, diags
+}
+
+func applyRoleResponse(state *RoleModel, resp roleResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.Title != "" {
+		state.Title = types.StringValue(resp.Title)
+	}
+	state.IsDefault = types.BoolValue(resp.IsDefault)
+	if resp.Permissions != nil {
+		state.Permissions = types.SetValueMust(types.StringType, stringSliceToAttrValues(resp.Permissions))
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SignalModel is the Terraform state model for a Seq signal.
+type SignalModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Filter      types.String `tfsdk:"filter"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	Shared      types.Bool   `tfsdk:"shared"`
+	CredentialOverride
+}
+
+type signalResponse struct {
+	ID          string `json:"Id"`
+	Title       string `json:"Title"`
+	Description string `json:"Description"`
+	OwnerID     string `json:"OwnerId"`
+	Shared      bool   `json:"Shared"`
+	Filters     []struct {
+		Filter string `json:"Filter"`
+	} `json:"Filters"`
+}
+
+// NewSignalResource manages Seq signals via /api/signals.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-signals
+func NewSignalResource() resource.Resource {
+	return newCRUDResource(crudConfig[SignalModel, signalResponse]{
+		typeNameSuffix: "signal",
+		basePath:       "/api/signals",
+		schema:         signalSchema(),
+		getID:          func(m SignalModel) string { return m.ID.ValueString() },
+		setID:          func(m *SignalModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  signalRequestBody,
+		applyResponse:  applySignalResponse,
+	})()
+}
+
+// NewSignalDataSource reads a single Seq signal by id.
+func NewSignalDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[SignalModel, signalResponse]{
+		typeNameSuffix: "signal",
+		basePath:       "/api/signals",
+		schema:         signalDataSourceSchema(),
+		getID:          func(m SignalModel) string { return m.ID.ValueString() },
+		applyResponse:  applySignalResponse,
+	})()
+}
+
+// NewSignalsDataSource lists every Seq signal.
+func NewSignalsDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "signals",
+		basePath:       "/api/signals",
+		description:    "Lists every Seq signal.",
+	})()
+}
+
+func signalSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a Seq signal: a saved, filterable view over events.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq signal id.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Human-friendly title for the signal.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer-form description of the signal.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Seq filter expression the signal matches, e.g. \"Application = 'Checkout'\".",
+				Optional:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "Owner principal id. Unset for a shared/global signal.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"shared": schema.BoolAttribute{
+				Description: "Whether the signal is shared with every user, rather than private to its owner.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func signalDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq signal by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq signal id.",
+				Required:    true,
+			},
+			"title": dsschema.StringAttribute{
+				Description: "Human-friendly title for the signal.",
+				Computed:    true,
+			},
+			"description": dsschema.StringAttribute{
+				Description: "Longer-form description of the signal.",
+				Computed:    true,
+			},
+			"filter": dsschema.StringAttribute{
+				Description: "Seq filter expression the signal matches.",
+				Computed:    true,
+			},
+			"owner_id": dsschema.StringAttribute{
+				Description: "Owner principal id.",
+				Computed:    true,
+			},
+			"shared": dsschema.BoolAttribute{
+				Description: "Whether the signal is shared with every user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func signalRequestBody(_ context.Context, plan SignalModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"Title": plan.Title.ValueString(),
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		body["Description"] = plan.Description.ValueString()
+	}
+	if !plan.OwnerID.IsNull() && !plan.OwnerID.IsUnknown() && plan.OwnerID.ValueString() != "" {
+		body["OwnerId"] = plan.OwnerID.ValueString()
+	}
+	if !plan.Shared.IsNull() && !plan.Shared.IsUnknown() {
+		body["Shared"] = plan.Shared.ValueBool()
+	}
+	if !plan.Filter.IsNull() && !plan.Filter.IsUnknown() && plan.Filter.ValueString() != "" {
+		body["Filters"] = []map[string]any{{"Filter": plan.Filter.ValueString()}}
+	}
+
+	return body, diags
+}
+
+func applySignalResponse(state *SignalModel, resp signalResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.Title != "" {
+		state.Title = types.StringValue(resp.Title)
+	}
+	state.Description = types.StringValue(resp.Description)
+	state.OwnerID = types.StringValue(resp.OwnerID)
+	state.Shared = types.BoolValue(resp.Shared)
+	if len(resp.Filters) > 0 {
+		state.Filter = types.StringValue(resp.Filters[0].Filter)
+	} else {
+		state.Filter = types.StringValue("")
+	}
+}
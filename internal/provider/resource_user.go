@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserModel is the Terraform state model for a Seq user.
+type UserModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Email       types.String `tfsdk:"email"`
+	RoleIDs     types.Set    `tfsdk:"role_ids"`
+	CredentialOverride
+}
+
+type userResponse struct {
+	ID          string   `json:"Id"`
+	Username    string   `json:"Username"`
+	DisplayName string   `json:"DisplayName"`
+	Email       string   `json:"EmailAddress"`
+	RoleIDs     []string `json:"RoleIds"`
+}
+
+// NewUserResource manages Seq users via /api/users.
+//
+// Ref: https://datalust.co/docs/server-http-api#api-users
+func NewUserResource() resource.Resource {
+	return newCRUDResource(crudConfig[UserModel, userResponse]{
+		typeNameSuffix: "user",
+		basePath:       "/api/users",
+		schema:         userSchema(),
+		getID:          func(m UserModel) string { return m.ID.ValueString() },
+		setID:          func(m *UserModel, id string) { m.ID = types.StringValue(id) },
+		toRequestBody:  userRequestBody,
+		applyResponse:  applyUserResponse,
+	})()
+}
+
+// NewUserDataSource reads a single Seq user by id.
+func NewUserDataSource() datasource.DataSource {
+	return newItemDataSource(itemDataSourceConfig[UserModel, userResponse]{
+		typeNameSuffix: "user",
+		basePath:       "/api/users",
+		schema:         userDataSourceSchema(),
+		getID:          func(m UserModel) string { return m.ID.ValueString() },
+		applyResponse:  applyUserResponse,
+	})()
+}
+
+// NewUsersDataSource lists every Seq user.
+func NewUsersDataSource() datasource.DataSource {
+	return newListDataSource(listDataSourceConfig{
+		typeNameSuffix: "users",
+		basePath:       "/api/users",
+		description:    "Lists every Seq user.",
+	})()
+}
+
+func userSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages a Seq user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Seq user id.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Login name for the user.",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Friendly display name for the user.",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address for the user.",
+				Optional:    true,
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "Ids of the roles assigned to the user.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"api_key_override": credentialOverrideAttribute,
+		},
+	}
+}
+
+func userDataSourceSchema() dsschema.Schema {
+	return dsschema.Schema{
+		Description: "Reads a single Seq user by id.",
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description: "Seq user id.",
+				Required:    true,
+			},
+			"username": dsschema.StringAttribute{
+				Description: "Login name for the user.",
+				Computed:    true,
+			},
+			"display_name": dsschema.StringAttribute{
+				Description: "Friendly display name for the user.",
+				Computed:    true,
+			},
+			"email": dsschema.StringAttribute{
+				Description: "Email address for the user.",
+				Computed:    true,
+			},
+			"role_ids": dsschema.SetAttribute{
+				Description: "Ids of the roles assigned to the user.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func userRequestBody(ctx context.Context, plan UserModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]any{
+		"Username": plan.Username.ValueString(),
+	}
+	if !plan.DisplayName.IsNull() && !plan.DisplayName.IsUnknown() {
+		body["DisplayName"] = plan.DisplayName.ValueString()
+	}
+	if !plan.Email.IsNull() && !plan.Email.IsUnknown() {
+		body["EmailAddress"] = plan.Email.ValueString()
+	}
+	if !plan.RoleIDs.IsNull() && !plan.RoleIDs.IsUnknown() {
+		var roleIDs []string
+		diags.Append(plan.RoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		body["RoleIds"] = roleIDs
+	}
+
+	return body, diags
+}
+
+func applyUserResponse(state *UserModel, resp userResponse) {
+	if resp.ID != "" {
+		state.ID = types.StringValue(resp.ID)
+	}
+	if resp.Username != "" {
+		state.Username = types.StringValue(resp.Username)
+	}
+	state.DisplayName = types.StringValue(resp.DisplayName)
+	state.Email = types.StringValue(resp.Email)
+	if resp.RoleIDs != nil {
+		state.RoleIDs = types.SetValueMust(types.StringType, stringSliceToAttrValues(resp.RoleIDs))
+	}
+}
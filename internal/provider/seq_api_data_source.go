@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkvalidator "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*SeqAPIDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*SeqAPIDataSource)(nil)
+
+// SeqAPIDataSource reads an arbitrary Seq HTTP API GET endpoint, for
+// consuming Seq resources that don't have a dedicated data source yet
+// (workspaces, ...). Modeled on the Vault
+// provider's vault_generic_secret data source.
+//
+// It only ever issues GET requests; there is no attribute to choose a
+// different verb, since anything else wouldn't be safe to treat as a read.
+type SeqAPIDataSource struct {
+	client *Client
+}
+
+// SeqAPIModel is the Terraform data model for the seq_api data source.
+type SeqAPIModel struct {
+	Path       types.String `tfsdk:"path"`
+	Query      types.Map    `tfsdk:"query"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	DataJSON   types.String `tfsdk:"data_json"`
+	Data       types.Map    `tfsdk:"data"`
+}
+
+func NewSeqAPIDataSource() datasource.DataSource {
+	return &SeqAPIDataSource{}
+}
+
+func (d *SeqAPIDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api"
+}
+
+func (d *SeqAPIDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an arbitrary Seq HTTP API endpoint with GET. Useful for Seq resources not yet modeled as a dedicated data source; pair with jsondecode() to pull fields out of data_json.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Seq API path to read, e.g. \"/api/signals\".",
+				Required:    true,
+				Validators: []frameworkvalidator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"query": schema.MapAttribute{
+				Description: "Optional query string parameters to send with the request.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"status_code": schema.Int64Attribute{
+				Description: "HTTP status code returned by the Seq server for a failed request. Any successful (2xx) response is recorded as 200.",
+				Computed:    true,
+			},
+			"data_json": schema.StringAttribute{
+				Description: "Raw JSON response body. Decode with jsondecode() for interpolation into other resources.",
+				Computed:    true,
+			},
+			"data": schema.MapAttribute{
+				Description: "Shallow decode of data_json: top-level object keys mapped to their values as strings. Nested objects/arrays are re-encoded as JSON strings.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SeqAPIDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *provider.Client, got a different type.",
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *SeqAPIDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", errNotConfigured.Error())
+		return
+	}
+
+	var data SeqAPIModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path, diags := seqAPIRequestPath(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var raw json.RawMessage
+	err := d.client.doJSON(ctx, http.MethodGet, path, nil, &raw)
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		data.StatusCode = types.Int64Value(int64(httpErr.StatusCode))
+		resp.Diagnostics.AddError("Seq API request failed", httpErr.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Seq API request failed", err.Error())
+		return
+	}
+
+	data.StatusCode = types.Int64Value(http.StatusOK)
+	data.DataJSON = types.StringValue(string(raw))
+	data.Data = shallowJSONToMap(raw)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// seqAPIRequestPath builds the request path, appending any query params.
+func seqAPIRequestPath(ctx context.Context, data SeqAPIModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := data.Path.ValueString()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	if data.Query.IsNull() || data.Query.IsUnknown() {
+		return path, diags
+	}
+
+	var query map[string]string
+	diags.Append(data.Query.ElementsAs(ctx, &query, false)...)
+	if diags.HasError() || len(query) == 0 {
+		return path, diags
+	}
+
+	values := url.Values{}
+	for k, v := range query {
+		values.Set(k, v)
+	}
+	return path + "?" + values.Encode(), diags
+}
+
+// shallowJSONToMap decodes raw as a JSON object and stringifies its
+// top-level values: scalars via their natural string form, nested
+// objects/arrays by re-encoding them as JSON. Anything that isn't a JSON
+// object (e.g. an array response) yields an empty map.
+func shallowJSONToMap(raw json.RawMessage) types.Map {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	values := make(map[string]attr.Value, len(obj))
+	for k, v := range obj {
+		var scalar any
+		if err := json.Unmarshal(v, &scalar); err == nil {
+			if s, ok := scalar.(string); ok {
+				values[k] = types.StringValue(s)
+				continue
+			}
+		}
+		values[k] = types.StringValue(strings.TrimSpace(string(v)))
+	}
+
+	return types.MapValueMust(types.StringType, values)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShallowJSONToMap(t *testing.T) {
+	raw := json.RawMessage(`{"Title":"x","Count":3,"Tags":["a","b"]}`)
+	m := shallowJSONToMap(raw)
+
+	var got map[string]string
+	if diags := m.ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got["Title"] != "x" {
+		t.Fatalf("expected Title to be passed through as a string, got %q", got["Title"])
+	}
+	if got["Count"] != "3" {
+		t.Fatalf("expected Count to be stringified, got %q", got["Count"])
+	}
+	if got["Tags"] != `["a","b"]` {
+		t.Fatalf("expected Tags to be re-encoded as JSON, got %q", got["Tags"])
+	}
+}
+
+func TestSeqAPIRequestPathAppendsQuery(t *testing.T) {
+	data := SeqAPIModel{
+		Path: types.StringValue("api/signals"),
+		Query: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"filter": types.StringValue("Application"),
+		}),
+	}
+
+	path, diags := seqAPIRequestPath(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if path != "/api/signals?filter=Application" {
+		t.Fatalf("unexpected path: %q", path)
+	}
+}
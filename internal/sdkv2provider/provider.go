@@ -0,0 +1,132 @@
+// Package sdkv2provider implements a companion terraform-plugin-sdk/v2
+// provider that is muxed together with the terraform-plugin-framework
+// provider in package provider (see main.go). It exists so that Seq API
+// surface can be modeled with the SDK v2's more mature schema helpers while
+// existing resources like seq_api_key keep using the modern framework style.
+//
+// Both sides of the mux share the same *provider.Client and SEQ_* env vars;
+// see provider.ApplyEnvDefaults.
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/example/terraform-provider-seq/internal/provider"
+)
+
+// New returns a factory for the SDK v2 side of the Seq provider.
+func New(version string) func() *schema.Provider {
+	return func() *schema.Provider {
+		p := &schema.Provider{
+			Schema: map[string]*schema.Schema{
+				"server_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Base URL for the Seq server. Can be set via SEQ_SERVER_URL.",
+				},
+				"auth_mode": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Authentication scheme to use: \"api_key\" (default), \"bearer\", \"basic\", or \"none\". Can be set via SEQ_AUTH_MODE.",
+				},
+				"api_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Seq API key used for authentication. Can be set via SEQ_API_KEY.",
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Username for HTTP basic auth, used when auth_mode is \"basic\". Can be set via SEQ_USERNAME.",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password for HTTP basic auth, used when auth_mode is \"basic\". Can be set via SEQ_PASSWORD.",
+				},
+				"bearer_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Bearer token sent via the Authorization header, used when auth_mode is \"bearer\". Can be set via SEQ_BEARER_TOKEN.",
+				},
+				"insecure_skip_verify": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Skip TLS certificate verification (NOT recommended). Can be set via SEQ_INSECURE_SKIP_VERIFY.",
+				},
+				"timeout_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "HTTP client timeout in seconds. Can be set via SEQ_TIMEOUT_SECONDS.",
+				},
+				"max_retries": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Maximum number of retries for requests that fail with a network error, 408, 429, or 5xx response. Defaults to 5. Can be set via SEQ_MAX_RETRIES.",
+				},
+				"retry_max_wait_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Upper bound, in seconds, on how long to wait between retries, including any Retry-After value returned by the server. Defaults to 30.",
+				},
+			},
+			ResourcesMap: map[string]*schema.Resource{
+				"seq_workspace": resourceWorkspace(),
+			},
+			DataSourcesMap: map[string]*schema.Resource{},
+		}
+
+		p.ConfigureContextFunc = configure(version)
+
+		return p
+	}
+}
+
+func configure(version string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		cfg := provider.ApplyEnvDefaults(provider.ClientConfig{
+			ServerURL:          d.Get("server_url").(string),
+			AuthMode:           d.Get("auth_mode").(string),
+			APIKey:             d.Get("api_key").(string),
+			Username:           d.Get("username").(string),
+			Password:           d.Get("password").(string),
+			BearerToken:        d.Get("bearer_token").(string),
+			InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+			Timeout:            secondsToDuration(d.Get("timeout_seconds").(int)),
+			UserAgent:          fmt.Sprintf("terraform-provider-seq/%s (terraform-plugin-sdk/v2)", version),
+			MaxRetries:         maxRetriesPtr(d),
+			RetryMaxWait:       secondsToDuration(d.Get("retry_max_wait_seconds").(int)),
+		})
+		if cfg.ServerURL == "" {
+			return nil, diag.Errorf("Configure the provider with server_url or set SEQ_SERVER_URL.")
+		}
+
+		client, err := provider.NewClient(cfg)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, nil
+	}
+}
+
+// maxRetriesPtr returns a pointer to the configured max_retries value, or
+// nil if it was left unset in the config - letting ApplyEnvDefaults tell an
+// explicit 0 (disable retries) apart from "not configured" (use the
+// default). d.Get alone can't make that distinction, since it returns the
+// zero value either way.
+func maxRetriesPtr(d *schema.ResourceData) *int {
+	v, ok := d.GetOkExists("max_retries")
+	if !ok {
+		return nil
+	}
+	i := v.(int)
+	return &i
+}
@@ -0,0 +1,102 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/example/terraform-provider-seq/internal/provider"
+)
+
+// resourceWorkspace manages a Seq workspace via /api/workspaces. It is the
+// first SDK v2 side resource, added mainly to prove that the framework and
+// SDK v2 providers can be muxed together; Seq API coverage continues to
+// grow primarily on the framework side (see package provider).
+func resourceWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceCreate,
+		ReadContext:   resourceWorkspaceRead,
+		UpdateContext: resourceWorkspaceUpdate,
+		DeleteContext: resourceWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Human-friendly name for the workspace.",
+			},
+		},
+	}
+}
+
+type workspaceResponse struct {
+	ID   string `json:"Id"`
+	Name string `json:"Name"`
+}
+
+func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Client)
+
+	var created workspaceResponse
+	body := map[string]any{"Name": d.Get("name").(string)}
+	if err := client.Do(ctx, http.MethodPost, "/api/workspaces", body, &created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(created.ID)
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Client)
+
+	var got workspaceResponse
+	err := client.Do(ctx, http.MethodGet, "/api/workspaces/"+d.Id(), nil, &got)
+	var httpErr *provider.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", got.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Client)
+
+	body := map[string]any{"Name": d.Get("name").(string)}
+	if err := client.Do(ctx, http.MethodPut, "/api/workspaces/"+d.Id(), body, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Client)
+
+	err := client.Do(ctx, http.MethodDelete, "/api/workspaces/"+d.Id(), nil, nil)
+	var httpErr *provider.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func secondsToDuration(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -6,24 +6,66 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
 	"github.com/example/terraform-provider-seq/internal/provider"
+	"github.com/example/terraform-provider-seq/internal/sdkv2provider"
 )
 
 var version = "dev"
 
+const providerAddress = "registry.terraform.io/example/seq"
+
 // terraform-provider-seq entrypoint.
+//
+// The provider is served as a mux of two providers sharing one address: the
+// terraform-plugin-framework provider in package provider (protocol 6
+// natively) and a companion terraform-plugin-sdk/v2 provider in package
+// sdkv2provider, upgraded from protocol 5 to 6 so the two can be combined.
+// This lets existing resources keep the framework style while new Seq API
+// coverage can be added on the SDK v2 side where that's a better fit.
 func main() {
 	var debug bool
 	flag.BoolVar(&debug, "debug", false, "start provider in debug mode")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/example/seq",
-		Debug:   debug,
+	muxServerFactory, err := newMuxServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	if err := providerserver.Serve(context.Background(), provider.New(version), opts); err != nil {
+	if err := tf6server.Serve(providerAddress, muxServerFactory, serveOpts...); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// newMuxServerFactory builds the combined protocol 6 provider server
+// factory: the framework provider as-is, plus the SDK v2 provider upgraded
+// from protocol 5. Split out from main so acceptance tests can exercise the
+// same mux.
+func newMuxServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	upgradedSDKProvider, err := tf5to6server.UpgradeServer(ctx, sdkv2provider.New(version)().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version)()),
+		func() tfprotov6.ProviderServer { return upgradedSDKProvider },
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccMuxedProvider_PlansResourcesFromBothSides is a smoke test proving
+// the framework provider (seq_api_key) and the SDK v2 provider
+// (seq_workspace) can be planned together through the single muxed address.
+// It never applies, since CI has no real Seq server to talk to.
+func TestAccMuxedProvider_PlansResourcesFromBothSides(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("set TF_ACC=1 to run acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"seq": func() (tfprotov6.ProviderServer, error) {
+				factory, err := newMuxServerFactory(context.Background())
+				if err != nil {
+					return nil, err
+				}
+				return factory(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "seq" {
+  server_url = "http://localhost:5342"
+  api_key    = "test"
+}
+
+resource "seq_api_key" "test" {
+  title = "acc-test"
+}
+
+resource "seq_workspace" "test" {
+  name = "acc-test"
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}